@@ -0,0 +1,57 @@
+package paging
+
+// ArgsBuilder builds PageArgs without having to take the address of literals
+type ArgsBuilder struct {
+	args PageArgs
+}
+
+// NewArgs returns a new PageArgs builder
+func NewArgs() *ArgsBuilder {
+	return &ArgsBuilder{}
+}
+
+// First sets the number of records to return
+func (b *ArgsBuilder) First(first int) *ArgsBuilder {
+	b.args.First = &first
+	return b
+}
+
+// After sets the cursor to return records after
+func (b *ArgsBuilder) After(cursor *string) *ArgsBuilder {
+	b.args.After = cursor
+	return b
+}
+
+// Last sets the number of records to return, counting back from the end of the list or the `before` cursor
+func (b *ArgsBuilder) Last(last int) *ArgsBuilder {
+	b.args.Last = &last
+	return b
+}
+
+// Before sets the cursor to return records before
+func (b *ArgsBuilder) Before(cursor *string) *ArgsBuilder {
+	b.args.Before = cursor
+	return b
+}
+
+// SortAsc sorts by the columns in ascending order
+func (b *ArgsBuilder) SortAsc(cols ...string) *ArgsBuilder {
+	WithSortBy(&b.args, false, cols...)
+	return b
+}
+
+// SortDesc sorts by the columns in descending order
+func (b *ArgsBuilder) SortDesc(cols ...string) *ArgsBuilder {
+	WithSortBy(&b.args, true, cols...)
+	return b
+}
+
+// Build returns the PageArgs, validated with ValidateRelayArgs
+func (b *ArgsBuilder) Build() (*PageArgs, error) {
+	args := b.args
+
+	if err := ValidateRelayArgs(&args); err != nil {
+		return nil, err
+	}
+	return &args, nil
+}
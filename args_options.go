@@ -0,0 +1,42 @@
+package paging
+
+// PageArgsOption sets a field of PageArgs
+type PageArgsOption func(pa *PageArgs)
+
+// NewPageArgs returns PageArgs with the options applied, e.g. NewPageArgs(First(20), After(cursor))
+func NewPageArgs(opts ...PageArgsOption) *PageArgs {
+	pa := &PageArgs{}
+
+	for _, opt := range opts {
+		opt(pa)
+	}
+	return pa
+}
+
+// First sets the number of records to return
+func First(first int) PageArgsOption {
+	return func(pa *PageArgs) {
+		pa.First = &first
+	}
+}
+
+// After sets the cursor to return records after
+func After(cursor *string) PageArgsOption {
+	return func(pa *PageArgs) {
+		pa.After = cursor
+	}
+}
+
+// Last sets the number of records to return, counting back from the end of the list or the `before` cursor
+func Last(last int) PageArgsOption {
+	return func(pa *PageArgs) {
+		pa.Last = &last
+	}
+}
+
+// Before sets the cursor to return records before
+func Before(cursor *string) PageArgsOption {
+	return func(pa *PageArgs) {
+		pa.Before = cursor
+	}
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 )
 
+const arrayConnectionPrefix = "arrayconnection"
+
 // EncodeOffsetCursor takes an integer and encodes to a base64 string as "cursor:offset:NUMBER"
 func EncodeOffsetCursor(offset int) *string {
 	data := "cursor:offset:" + strconv.Itoa(offset)
@@ -13,8 +15,18 @@ func EncodeOffsetCursor(offset int) *string {
 	return &encoded
 }
 
+// EncodeArrayConnectionCursor takes an integer and encodes it the way Relay's connectionFromArray does,
+// as a base64 string of "arrayconnection:NUMBER". Relay cursors point at an item rather than at the
+// next offset, so NUMBER is offset - 1
+func EncodeArrayConnectionCursor(offset int) *string {
+	data := arrayConnectionPrefix + ":" + strconv.Itoa(offset-1)
+	encoded := base64.StdEncoding.EncodeToString([]byte(data))
+	return &encoded
+}
+
 // DecodeOffsetCursor takes a base64 string and decotes it to extract the
 // offset from a string based on "cursor:offset:NUMBER". It defails to 0 if cannot decode or has any error.
+// Relay "arrayconnection:NUMBER" cursors are also accepted, so existing Relay clients keep working.
 func DecodeOffsetCursor(input *string) int {
 	if input == nil {
 		return 0
@@ -25,10 +37,14 @@ func DecodeOffsetCursor(input *string) int {
 	var err error
 
 	if decoded, err = base64.URLEncoding.DecodeString(*input); err != nil {
-		return 0
+		if decoded, err = base64.StdEncoding.DecodeString(*input); err != nil {
+			return 0
+		}
 	}
 
-	if data = strings.Split(string(decoded), ":"); len(data) == 3 {
+	data = strings.Split(string(decoded), ":")
+
+	if len(data) == 3 {
 		offset, err := strconv.ParseInt(data[2], 10, 32)
 
 		if err != nil {
@@ -37,5 +53,14 @@ func DecodeOffsetCursor(input *string) int {
 		return int(offset)
 	}
 
+	if len(data) == 2 && data[0] == arrayConnectionPrefix {
+		index, err := strconv.ParseInt(data[1], 10, 32)
+
+		if err != nil || index < 0 {
+			return 0
+		}
+		return int(index) + 1
+	}
+
 	return 0
 }
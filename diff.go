@@ -0,0 +1,89 @@
+package paging
+
+// PageDiff is the difference between two pages, in terms of record keys
+type PageDiff struct {
+	// Added are the keys only in the new page, in its order
+	Added []string
+	// Removed are the keys only in the old page, in its order
+	Removed []string
+	// Moved are the keys in both pages whose relative position changed, in the new page order
+	Moved []string
+}
+
+// DiffPages compares the record keys of two pages. Records kept in the same relative order are not
+// reported as moved, so an insertion at the top of the page only shows up in Added
+func DiffPages(a []string, b []string) PageDiff {
+	inA := make(map[string]bool, len(a))
+	for _, key := range a {
+		inA[key] = true
+	}
+
+	inB := make(map[string]bool, len(b))
+	for _, key := range b {
+		inB[key] = true
+	}
+
+	var diff PageDiff
+	var commonA, commonB []string
+
+	for _, key := range a {
+		if inB[key] {
+			commonA = append(commonA, key)
+		} else {
+			diff.Removed = append(diff.Removed, key)
+		}
+	}
+
+	for _, key := range b {
+		if inA[key] {
+			commonB = append(commonB, key)
+		} else {
+			diff.Added = append(diff.Added, key)
+		}
+	}
+
+	stable := longestCommonSubsequence(commonA, commonB)
+	for _, key := range commonB {
+		if !stable[key] {
+			diff.Moved = append(diff.Moved, key)
+		}
+	}
+
+	return diff
+}
+
+func longestCommonSubsequence(a []string, b []string) map[string]bool {
+	lengths := make([][]int, len(a)+1)
+	for i := range lengths {
+		lengths[i] = make([]int, len(b)+1)
+	}
+
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			switch {
+			case a[i] == b[j]:
+				lengths[i][j] = lengths[i+1][j+1] + 1
+			case lengths[i+1][j] >= lengths[i][j+1]:
+				lengths[i][j] = lengths[i+1][j]
+			default:
+				lengths[i][j] = lengths[i][j+1]
+			}
+		}
+	}
+
+	result := map[string]bool{}
+	for i, j := 0, 0; i < len(a) && j < len(b); {
+		switch {
+		case a[i] == b[j]:
+			result[a[i]] = true
+			i++
+			j++
+		case lengths[i+1][j] >= lengths[i][j+1]:
+			i++
+		default:
+			j++
+		}
+	}
+
+	return result
+}
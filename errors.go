@@ -0,0 +1,32 @@
+package paging
+
+import "errors"
+
+var (
+	// ErrPageOutOfRange is returned when the requested offset is beyond the total count and the
+	// OutOfRangeError policy is set
+	ErrPageOutOfRange = errors.New("paging: page out of range")
+
+	// ErrPageSizeTooLarge is returned when `first` exceeds PageConfig.HardMaxLimit
+	ErrPageSizeTooLarge = errors.New("paging: page size too large")
+
+	// ErrCursorTooLong is returned when a cursor exceeds PageConfig.MaxCursorLength
+	ErrCursorTooLong = errors.New("paging: cursor too long")
+
+	// ErrSortConflict is returned when the same column is sorted in both directions
+	ErrSortConflict = errors.New("paging: conflicting sort columns")
+
+	// ErrCursorNotAllowed is returned by TopKQueryMods when a cursor is given
+	ErrCursorNotAllowed = errors.New("paging: cursor not allowed")
+)
+
+// ArgumentError is returned when the paging arguments break the Relay connection rules.
+// Its message is written to be returned to GraphQL clients as is
+type ArgumentError struct {
+	Argument string
+	Message  string
+}
+
+func (e *ArgumentError) Error() string {
+	return e.Message
+}
@@ -0,0 +1,62 @@
+package paging
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"hash"
+	"strings"
+)
+
+// PageETag returns a strong HTTP ETag for a page, computed from the identifiers of its records, in order,
+// and the cursor used to request it. The same records at the same position produce the same ETag
+func PageETag(ids []string, cursor *string) string {
+	sum := sha256.New()
+
+	writeOptionalHashField(sum, cursor)
+
+	for _, id := range ids {
+		writeHashField(sum, id)
+	}
+
+	return `"` + hex.EncodeToString(sum.Sum(nil)[:16]) + `"`
+}
+
+// ETagMatches reports whether the If-None-Match header value matches the ETag, in which case
+// the endpoint can reply with 304 Not Modified
+func ETagMatches(ifNoneMatch string, etag string) bool {
+	ifNoneMatch = strings.TrimSpace(ifNoneMatch)
+	if ifNoneMatch == "*" {
+		return true
+	}
+
+	for _, candidate := range strings.Split(ifNoneMatch, ",") {
+		candidate = strings.TrimPrefix(strings.TrimSpace(candidate), "W/")
+		if candidate == strings.TrimPrefix(etag, "W/") {
+			return true
+		}
+	}
+
+	return false
+}
+
+// writeHashField writes a length prefixed value, so that ["ab", "c"] and ["a", "bc"] hash differently
+func writeHashField(sum hash.Hash, value string) {
+	size := make([]byte, 8)
+	binary.BigEndian.PutUint64(size, uint64(len(value)))
+
+	sum.Write(size)
+	sum.Write([]byte(value))
+}
+
+// writeOptionalHashField writes whether the value is set before the value itself, so that nil and ""
+// hash differently
+func writeOptionalHashField(sum hash.Hash, value *string) {
+	if value == nil {
+		sum.Write([]byte{0})
+		return
+	}
+
+	sum.Write([]byte{1})
+	writeHashField(sum, *value)
+}
@@ -0,0 +1,79 @@
+package paging
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// IDListPaginator is the paginator for an ordered list of IDs supplied by the caller, e.g. by a
+// recommendation service. Cursors are offsets in that list, and only the IDs of the current page
+// are hydrated from the database
+type IDListPaginator struct {
+	OffsetPaginator
+	// IDs are the IDs of the current page, in the order of the list
+	IDs      []string
+	idColumn string
+}
+
+// NewIDListPaginator creates a new paginator over the ids, to be loaded by the idColumn
+func NewIDListPaginator(page *PageArgs, ids []string, idColumn string) IDListPaginator {
+	paginator := NewOffsetPaginator(page, int64(len(ids)))
+
+	start := clampIndex(paginator.Offset, 0, len(ids))
+	end := clampIndex(start+paginator.Limit, start, len(ids))
+
+	return IDListPaginator{
+		OffsetPaginator: paginator,
+		IDs:             ids[start:end],
+		idColumn:        idColumn,
+	}
+}
+
+// QueryMods returns the sqlboiler query mods loading the records of the current page.
+// The database returns them in any order: use Order to put them back in the order of the list
+func (p *IDListPaginator) QueryMods() []qm.QueryMod {
+	if len(p.IDs) == 0 {
+		return []qm.QueryMod{qm.Where("1 = 0")}
+	}
+
+	args := make([]interface{}, len(p.IDs))
+	for i, id := range p.IDs {
+		args[i] = id
+	}
+
+	return []qm.QueryMod{
+		qm.WhereIn(p.idColumn+" IN ?", args...),
+	}
+}
+
+// Order puts the loaded records back in the order of the list. keys are the IDs of the records, in
+// the order the database returned them. The i-th element is the index in keys of the i-th ID of the
+// page, to be used along with EdgeCursor(i), or -1 when that record was not loaded, e.g. deleted
+func (p *IDListPaginator) Order(keys []string) []int {
+	indexes := make(map[string]int, len(keys))
+	for i, key := range keys {
+		if _, ok := indexes[key]; !ok {
+			indexes[key] = i
+		}
+	}
+
+	order := make([]int, len(p.IDs))
+	for i, id := range p.IDs {
+		index, ok := indexes[id]
+		if !ok {
+			index = -1
+		}
+		order[i] = index
+	}
+
+	return order
+}
+
+func clampIndex(index int, min int, max int) int {
+	if index < min {
+		return min
+	}
+	if index > max {
+		return max
+	}
+	return index
+}
@@ -0,0 +1,72 @@
+package paging
+
+import (
+	"encoding/json"
+)
+
+type pageInfoJSON struct {
+	HasPreviousPage    bool                `json:"hasPreviousPage"`
+	HasNextPage        bool                `json:"hasNextPage"`
+	TotalCount         *int64              `json:"totalCount"`
+	StartCursor        *string             `json:"startCursor"`
+	EndCursor          *string             `json:"endCursor"`
+	TotalCountEstimate *TotalCountEstimate `json:"totalCountEstimate,omitempty"`
+}
+
+// MarshalJSON resolves the PageInfo fields and encodes them with the same keys as the GraphQL schema,
+// so REST endpoints can return it directly
+func (p PageInfo) MarshalJSON() ([]byte, error) {
+	var data pageInfoJSON
+	var err error
+
+	if p.HasPreviousPage != nil {
+		if data.HasPreviousPage, err = p.HasPreviousPage(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.HasNextPage != nil {
+		if data.HasNextPage, err = p.HasNextPage(); err != nil {
+			return nil, err
+		}
+	}
+
+	// the int64 count is preferred, the int one overflows on 32-bit builds. Only one of them is resolved,
+	// so a count computed on demand runs once
+	if p.TotalCountInt64 != nil {
+		if data.TotalCount, err = p.TotalCountInt64(); err != nil {
+			return nil, err
+		}
+	}
+
+	if data.TotalCount == nil && p.TotalCount != nil {
+		totalCount, err := p.TotalCount()
+		if err != nil {
+			return nil, err
+		}
+		if totalCount != nil {
+			count := int64(*totalCount)
+			data.TotalCount = &count
+		}
+	}
+
+	if p.StartCursor != nil {
+		if data.StartCursor, err = p.StartCursor(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.EndCursor != nil {
+		if data.EndCursor, err = p.EndCursor(); err != nil {
+			return nil, err
+		}
+	}
+
+	if p.TotalCountEstimate != nil {
+		if data.TotalCountEstimate, err = p.TotalCountEstimate(); err != nil {
+			return nil, err
+		}
+	}
+
+	return json.Marshal(data)
+}
@@ -4,10 +4,40 @@ package paging
 type PageArgs struct {
 	First      *int    `json:"first,omitempty"`
 	After      *string `json:"after,omitempty"`
+	Last       *int    `json:"last,omitempty"`
+	Before     *string `json:"before,omitempty"`
 	sortByCols []string
 	isDesc     bool
+	nulls      NullsPosition
+	outOfRange OutOfRangePolicy
+	config     *PageConfig
+	codec      OffsetCursorCodec
 }
 
+// NullsPosition defines where NULL values are sorted
+type NullsPosition int
+
+const (
+	// NullsDefault leaves it to the database. Postgres sorts NULLs last in ascending order and first in descending order
+	NullsDefault NullsPosition = iota
+	// NullsFirst sorts NULL values before the others
+	NullsFirst
+	// NullsLast sorts NULL values after the others
+	NullsLast
+)
+
+// OutOfRangePolicy defines what the offset paginator does when the cursor points past the last record
+type OutOfRangePolicy int
+
+const (
+	// OutOfRangeEmpty returns an empty page. This is the default
+	OutOfRangeEmpty OutOfRangePolicy = iota
+	// OutOfRangeError reports ErrPageOutOfRange from OffsetPaginator.Err
+	OutOfRangeError
+	// OutOfRangeClamp moves the offset to the start of the last page
+	OutOfRangeClamp
+)
+
 func WithSortBy(pa *PageArgs, isDesc bool, cols ...string) *PageArgs {
 	if pa == nil {
 		pa = &PageArgs{}
@@ -18,12 +48,53 @@ func WithSortBy(pa *PageArgs, isDesc bool, cols ...string) *PageArgs {
 	return pa
 }
 
+// WithNullsPosition sets where NULL values are sorted. Like the direction, it applies to the last sort column;
+// to position NULLs of other columns, pass them as "col NULLS FIRST" to WithSortBy
+func WithNullsPosition(pa *PageArgs, nulls NullsPosition) *PageArgs {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	pa.nulls = nulls
+	return pa
+}
+
+// WithOffsetCursorCodec sets the codec the offset paginator uses to decode `after` and to build cursors
+func WithOffsetCursorCodec(pa *PageArgs, codec OffsetCursorCodec) *PageArgs {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	pa.codec = codec
+	return pa
+}
+
+// WithOutOfRangePolicy sets how the offset paginator handles an `after` cursor beyond the total count
+func WithOutOfRangePolicy(pa *PageArgs, policy OutOfRangePolicy) *PageArgs {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	pa.outOfRange = policy
+	return pa
+}
+
 // PageInfo is the base struct for building PageInfo. It expects inline functions for all the fields
 // We use inline functions so that one can build a lazy page info
 type PageInfo struct {
-	TotalCount      func() (*int, error)
-	HasPreviousPage func() (bool, error)
-	HasNextPage     func() (bool, error)
-	StartCursor     func() (*string, error)
-	EndCursor       func() (*string, error)
+	TotalCount         func() (*int, error)
+	HasPreviousPage    func() (bool, error)
+	HasNextPage        func() (bool, error)
+	StartCursor        func() (*string, error)
+	EndCursor          func() (*string, error)
+	TotalCountEstimate func() (*TotalCountEstimate, error)
+	// TotalCountInt64 is TotalCount without the conversion to int, which overflows on 32-bit builds
+	TotalCountInt64 func() (*int64, error)
+}
+
+// TotalCountEstimate is a total count that may be approximate, e.g. taken from
+// planner statistics or a search engine. Exact is false when Value is an estimate.
+type TotalCountEstimate struct {
+	Value int64 `json:"value"`
+	Exact bool  `json:"exact"`
 }
@@ -10,13 +10,18 @@ const defaultLimitVal = 50
 
 // OffsetPaginator is the paginator for offset based pagination
 type OffsetPaginator struct {
-	Limit    int
-	Offset   int
-	PageInfo PageInfo
-	orderBy  string
+	Limit      int
+	Offset     int
+	PageInfo   PageInfo
+	orderBy    string
+	codec      OffsetCursorCodec
+	totalCount int64
+	err        error
 }
 
-// NewOffsetPaginator creates a new offset paginator
+// NewOffsetPaginator creates a new offset paginator. Besides `first` and `after`, it supports Relay
+// backward pagination: with `last` and/or `before` the page is made of the last records before the
+// `before` cursor, or before the end of the list when it is not set
 func NewOffsetPaginator(
 	page *PageArgs,
 	totalCount int64,
@@ -27,6 +32,10 @@ func NewOffsetPaginator(
 	}
 
 	limit := defaultLimitVal
+	if page.config != nil && page.config.DefaultLimit > 0 {
+		limit = page.config.DefaultLimit
+	}
+
 	if len(defaultLimit) > 0 && defaultLimit[0] != nil {
 		limit = *defaultLimit[0]
 	}
@@ -35,30 +44,196 @@ func NewOffsetPaginator(
 		limit = *page.First
 	}
 
-	offset := DecodeOffsetCursor(page.After)
+	limit = page.config.clamp(limit)
 
-	orderBy := "created_at"
-	if len(page.sortByCols) > 0 {
-		orderBy = strings.Join(page.sortByCols, ", ")
+	codec := page.codec
+	if codec == nil {
+		codec = NewOffsetCursorCodec()
 	}
 
-	if page.isDesc {
-		orderBy = orderBy + " DESC"
+	offset := codec.Decode(page.After)
+
+	orderBy, err := orderByClause(page)
+	if err == nil {
+		err = page.config.Validate(page)
+	}
+
+	if err == nil && int64(offset) > totalCount {
+		switch page.outOfRange {
+		case OutOfRangeError:
+			err = ErrPageOutOfRange
+		case OutOfRangeClamp:
+			offset = lastPageOffset(limit, int(totalCount))
+		}
+	}
+
+	if page.Last != nil || page.Before != nil {
+		offset, limit = backwardWindow(page, codec, totalCount, offset, limit)
 	}
 
 	return OffsetPaginator{
-		Limit:    limit,
-		Offset:   offset,
-		PageInfo: NewOffsetBasedPageInfo(&limit, totalCount, offset),
-		orderBy:  orderBy,
+		Limit:      limit,
+		Offset:     offset,
+		PageInfo:   newOffsetBasedPageInfo(codec, &limit, totalCount, offset),
+		orderBy:    orderBy,
+		codec:      codec,
+		totalCount: totalCount,
+		err:        err,
+	}
+}
+
+// EdgeCursor returns the cursor of the i-th record of the page
+func (p *OffsetPaginator) EdgeCursor(i int) *string {
+	return p.cursorCodec().Encode(p.Offset + i + 1)
+}
+
+// LastPageCursor returns the cursor that, used as `after`, fetches the last page. Unlike the
+// LastPageCursor function, it is encoded with the codec set on the PageArgs
+func (p *OffsetPaginator) LastPageCursor() *string {
+	return p.cursorCodec().Encode(lastPageOffset(p.Limit, int(p.totalCount)))
+}
+
+// cursorCodec returns the codec of the paginator, or the default one for paginators not built
+// with NewOffsetPaginator
+func (p *OffsetPaginator) cursorCodec() OffsetCursorCodec {
+	if p.codec == nil {
+		return NewOffsetCursorCodec()
 	}
+	return p.codec
 }
 
-// QueryMods returns the sqlboilder query mods with pagination concerns
+// Err returns the error found while building the paginator, if any
+func (p *OffsetPaginator) Err() error {
+	return p.err
+}
+
+// QueryMods returns the sqlboilder query mods with pagination concerns.
+// A limit of 0 (`first: 0`) is a metadata-only page: sqlboiler drops `LIMIT 0`, so a false
+// condition is added instead to make sure no records are fetched. The same condition is added
+// when the paginator has an error, so a rejected page never runs the requested query
 func (p *OffsetPaginator) QueryMods() []qm.QueryMod {
-	return []qm.QueryMod{
+	mods := []qm.QueryMod{
 		qm.Offset(p.Offset),
 		qm.Limit(p.Limit),
 		qm.OrderBy(p.orderBy),
 	}
+
+	if p.Limit == 0 || p.err != nil {
+		mods = append(mods, qm.Where("1 = 0"))
+	}
+
+	return mods
+}
+
+// backwardWindow returns the offset and limit of the last records before the `before` cursor,
+// or the end of the list, and after offset. Without `last`, the limit is used as the page size
+func backwardWindow(
+	page *PageArgs,
+	codec OffsetCursorCodec,
+	totalCount int64,
+	offset int,
+	limit int,
+) (int, int) {
+	end := int(totalCount)
+	if page.Before != nil {
+		// the cursor of a record is its offset + 1, so records before it end at cursor - 1.
+		// an invalid cursor decodes to 0 and is ignored, like an invalid after cursor
+		if before := codec.Decode(page.Before); before > 0 && before-1 < end {
+			end = before - 1
+		}
+	}
+
+	if end < offset {
+		end = offset
+	}
+
+	if page.First != nil && offset+limit < end {
+		end = offset + limit
+	}
+
+	last := limit
+	if page.Last != nil {
+		last = page.config.clamp(*page.Last)
+	}
+
+	if last < 0 {
+		last = 0
+	}
+
+	if end-offset > last {
+		offset = end - last
+	}
+
+	return offset, end - offset
+}
+
+func orderByClause(page *PageArgs) (string, error) {
+	cols, err := normalizeSortCols(sortColumns(page))
+	return strings.Join(cols, ", "), err
+}
+
+// sortColumns returns the sort columns of page, defaulting to created_at, with the direction and the
+// nulls position applied to the last one
+func sortColumns(page *PageArgs) []string {
+	var cols []string
+	for _, col := range page.sortByCols {
+		if strings.TrimSpace(col) != "" {
+			cols = append(cols, col)
+		}
+	}
+
+	if len(cols) == 0 {
+		cols = []string{"created_at"}
+	}
+
+	// the direction and the nulls position apply to the last column, so they are part of it
+	// when looking for repeated and conflicting columns
+	last := len(cols) - 1
+	if page.isDesc {
+		cols[last] = cols[last] + " DESC"
+	}
+
+	switch page.nulls {
+	case NullsFirst:
+		cols[last] = cols[last] + " NULLS FIRST"
+	case NullsLast:
+		cols[last] = cols[last] + " NULLS LAST"
+	}
+
+	return cols
+}
+
+// normalizeSortCols trims the sort columns and drops the repeated ones, keeping the first occurrence.
+// It returns ErrSortConflict when a column is sorted in both directions
+func normalizeSortCols(cols []string) ([]string, error) {
+	var result []string
+	var err error
+
+	directions := map[string]string{}
+	for _, col := range cols {
+		fields := strings.Fields(col)
+		if len(fields) == 0 {
+			continue
+		}
+
+		direction := "ASC"
+		for _, field := range fields[1:] {
+			if strings.EqualFold(field, "DESC") {
+				direction = "DESC"
+			}
+		}
+
+		name := strings.ToLower(fields[0])
+		if seen, ok := directions[name]; ok {
+			if seen != direction && err == nil {
+				err = ErrSortConflict
+			}
+			continue
+		}
+
+		directions[name] = direction
+		result = append(result, strings.Join(fields, " "))
+	}
+
+	return result, err
 }
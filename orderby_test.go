@@ -113,4 +113,101 @@ var _ = Describe("Order By clause", func() {
 			})
 		})
 	})
+
+	Describe("Nulls position", func() {
+		It("should not add a NULLS clause by default", func() {
+			pa = WithSortBy(pa, true, cols...)
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.orderBy).To(Equal("col1, col2 DESC"))
+		})
+
+		It("should add NULLS FIRST", func() {
+			pa = WithNullsPosition(WithSortBy(pa, false, cols...), NullsFirst)
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.orderBy).To(Equal("col1, col2 NULLS FIRST"))
+		})
+
+		It("should add NULLS LAST after the direction", func() {
+			pa = WithNullsPosition(WithSortBy(pa, true, cols...), NullsLast)
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.orderBy).To(Equal("col1, col2 DESC NULLS LAST"))
+		})
+
+		It("should handle a nil PageArgs arg", func() {
+			pa := WithNullsPosition(nil, NullsLast)
+			Expect(pa).ToNot(BeNil())
+		})
+	})
+
+	Describe("Duplicated columns", func() {
+		It("should drop repeated columns", func() {
+			pa = WithSortBy(pa, false, "col1", " col2", "col1", "col2 ASC")
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.orderBy).To(Equal("col1, col2"))
+			Expect(sut.Err()).To(BeNil())
+		})
+
+		It("should report columns sorted in both directions", func() {
+			pa = WithSortBy(pa, false, "created_at DESC", "created_at ASC")
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.Err()).To(Equal(ErrSortConflict))
+		})
+
+		It("should report a repeated last column reversed by the desc flag", func() {
+			pa = WithSortBy(pa, true, "created_at", "id", "created_at")
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.Err()).To(Equal(ErrSortConflict))
+		})
+
+		It("should keep the desc flag on a repeated last column sorted the same way", func() {
+			pa = WithSortBy(pa, true, "created_at DESC", "id", "created_at")
+			sut := NewOffsetPaginator(pa, 5)
+
+			Expect(sut.orderBy).To(Equal("created_at DESC, id"))
+			Expect(sut.Err()).To(BeNil())
+		})
+	})
+	Describe("Normalized sort", func() {
+		It("should sort the same way as the given PageArgs", func() {
+			for _, page := range []*PageArgs{
+				WithSortBy(nil, true, "a DESC", "id", "a"),
+				WithSortBy(nil, false, " a", "id", "a"),
+				WithSortBy(nil, true),
+				WithNullsPosition(WithSortBy(nil, true, "a", "id"), NullsLast),
+				WithNullsPosition(WithSortBy(nil, false, "a", "id", "a NULLS FIRST"), NullsFirst),
+			} {
+				normalized, _ := Normalize(page, nil)
+
+				expected := NewOffsetPaginator(page, 5)
+				sut := NewOffsetPaginator(normalized, 5)
+
+				Expect(sut.orderBy).To(Equal(expected.orderBy))
+				Expect(sut.Err()).To(BeNil())
+			}
+		})
+
+		It("should keep the sort conflicts", func() {
+			page := WithSortBy(nil, true, "a", "id", "a")
+			normalized, _ := Normalize(page, nil)
+
+			expected := NewOffsetPaginator(page, 5)
+			sut := NewOffsetPaginator(normalized, 5)
+
+			Expect(sut.orderBy).To(Equal(expected.orderBy))
+			Expect(sut.Err()).To(Equal(ErrSortConflict))
+		})
+
+		It("should not hash a conflict like the valid columns", func() {
+			_, conflict := Normalize(WithSortBy(nil, true, "a", "id", "a"), nil)
+			_, valid := Normalize(WithSortBy(nil, true, "a", "id"), nil)
+
+			Expect(conflict).ToNot(Equal(valid))
+		})
+	})
 })
@@ -0,0 +1,189 @@
+package paging
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"strconv"
+	"strings"
+)
+
+// PageConfig holds the paging settings of an endpoint
+type PageConfig struct {
+	DefaultLimit int
+	// SoftMaxLimit is the largest page size served, bigger `first` values are clamped to it. Zero means no limit
+	SoftMaxLimit int
+	// HardMaxLimit is the largest `first` accepted, bigger values are rejected. Zero means no limit
+	HardMaxLimit int
+	// MaxCursorLength is the maximum accepted length of the `after` and `before` cursors. Zero means no limit
+	MaxCursorLength int
+}
+
+// WithPageConfig attaches the config to the PageArgs so the paginators apply its defaults and limits
+func WithPageConfig(pa *PageArgs, config *PageConfig) *PageArgs {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	pa.config = config
+	return pa
+}
+
+// PageConfigFunc resolves the PageConfig for the caller, e.g. based on the API key tier or the user role
+// found in the context. It returns nil when there is no caller specific config
+type PageConfigFunc func(ctx context.Context) *PageConfig
+
+// WithPageConfigFunc attaches the config resolved for the caller to the PageArgs. When the func returns
+// nil the config already attached, if any, is kept
+func WithPageConfigFunc(ctx context.Context, pa *PageArgs, fn PageConfigFunc) *PageArgs {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	if fn == nil {
+		return pa
+	}
+
+	if config := fn(ctx); config != nil {
+		pa.config = config
+	}
+	return pa
+}
+
+// EffectiveLimit returns the page size for the PageArgs: `first`, or the default limit when not set,
+// clamped to SoftMaxLimit
+func (c *PageConfig) EffectiveLimit(pa *PageArgs) int {
+	limit := defaultLimitVal
+	if c != nil && c.DefaultLimit > 0 {
+		limit = c.DefaultLimit
+	}
+
+	if pa != nil && pa.First != nil {
+		limit = *pa.First
+	}
+
+	return c.clamp(limit)
+}
+
+// Validate checks the PageArgs against the config limits
+func (c *PageConfig) Validate(pa *PageArgs) error {
+	if c == nil || pa == nil {
+		return nil
+	}
+
+	if c.HardMaxLimit > 0 && pa.First != nil && *pa.First > c.HardMaxLimit {
+		return ErrPageSizeTooLarge
+	}
+
+	if c.HardMaxLimit > 0 && pa.Last != nil && *pa.Last > c.HardMaxLimit {
+		return ErrPageSizeTooLarge
+	}
+
+	if c.MaxCursorLength > 0 && pa.After != nil && len(*pa.After) > c.MaxCursorLength {
+		return ErrCursorTooLong
+	}
+
+	if c.MaxCursorLength > 0 && pa.Before != nil && len(*pa.Before) > c.MaxCursorLength {
+		return ErrCursorTooLong
+	}
+
+	return nil
+}
+
+func (c *PageConfig) clamp(limit int) int {
+	if c != nil && c.SoftMaxLimit > 0 && limit > c.SoftMaxLimit {
+		return c.SoftMaxLimit
+	}
+	return limit
+}
+
+// Normalize returns a canonical copy of PageArgs, with the config defaults and soft limit applied, the sort
+// direction folded into the sort columns, duplicated sort columns removed and the cursor trimmed, along with
+// a stable hash of it. Equivalent requests produce the same hash, so it can be used as a cache key or as a
+// request fingerprint in logs
+func Normalize(pa *PageArgs, config *PageConfig) (*PageArgs, string) {
+	if pa == nil {
+		pa = &PageArgs{}
+	}
+
+	// the config given here takes precedence over the one attached to the PageArgs, and is the one
+	// attached to the result so the paginators apply the same limits
+	if config == nil {
+		config = pa.config
+	}
+
+	// `first` only defaults to the config limit for forward pagination
+	var first, last *int
+	if pa.Last == nil {
+		limit := config.EffectiveLimit(pa)
+		first = &limit
+	} else {
+		limit := config.clamp(*pa.Last)
+		last = &limit
+
+		if pa.First != nil {
+			limit := config.clamp(*pa.First)
+			first = &limit
+		}
+	}
+
+	// the direction and the nulls position are folded into the columns, so the result sorts the same way.
+	// Conflicting columns are kept as they are for the paginators to report the conflict
+	cols := sortColumns(pa)
+	if normalized, err := normalizeSortCols(cols); err == nil {
+		cols = normalized
+	}
+
+	normalized := &PageArgs{
+		First:      first,
+		After:      trimCursor(pa.After),
+		Last:       last,
+		Before:     trimCursor(pa.Before),
+		sortByCols: cols,
+		outOfRange: pa.outOfRange,
+		config:     config,
+		codec:      pa.codec,
+	}
+
+	return normalized, normalized.hash()
+}
+
+func trimCursor(cursor *string) *string {
+	if cursor == nil {
+		return nil
+	}
+
+	if trimmed := strings.TrimSpace(*cursor); trimmed != "" {
+		return &trimmed
+	}
+	return nil
+}
+
+func (pa *PageArgs) hash() string {
+	sum := sha256.New()
+
+	writeOptionalHashField(sum, hashInt(pa.First))
+	writeOptionalHashField(sum, pa.After)
+	writeOptionalHashField(sum, hashInt(pa.Last))
+	writeOptionalHashField(sum, pa.Before)
+
+	writeHashField(sum, strconv.Itoa(len(pa.sortByCols)))
+	for _, col := range pa.sortByCols {
+		writeHashField(sum, col)
+	}
+
+	writeHashField(sum, strconv.FormatBool(pa.isDesc))
+	writeHashField(sum, strconv.Itoa(int(pa.nulls)))
+	writeHashField(sum, strconv.Itoa(int(pa.outOfRange)))
+
+	return hex.EncodeToString(sum.Sum(nil))
+}
+
+func hashInt(value *int) *string {
+	if value == nil {
+		return nil
+	}
+
+	data := strconv.Itoa(*value)
+	return &data
+}
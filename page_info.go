@@ -4,35 +4,92 @@ import (
 	"math"
 )
 
-// NewOffsetBasedPageInfo returns a new PageInfo object with data filled in, based on offset pagination
+// NewOffsetBasedPageInfo returns a new PageInfo object with data filled in, based on offset pagination.
+// StartCursor and EndCursor are the cursors of the first and last items of the current page, matching
+// the edge cursors built with EncodeOffsetCursor(offset + i + 1). Both are nil when the page is empty
 func NewOffsetBasedPageInfo(
 	pageSize *int,
 	totalCount int64,
 	currentOffset int,
+) PageInfo {
+	return newOffsetBasedPageInfo(NewOffsetCursorCodec(), pageSize, totalCount, currentOffset)
+}
+
+func newOffsetBasedPageInfo(
+	codec OffsetCursorCodec,
+	pageSize *int,
+	totalCount int64,
+	currentOffset int,
 ) PageInfo {
 	count := int(totalCount)
-	endOffset := count - int(math.Mod(float64(count), float64(*pageSize)))
+	hasNextPage := int64(currentOffset)+int64(*pageSize) < totalCount
 
-	if endOffset == count {
-		endOffset = count - *pageSize
+	var startCursor, endCursor *string
+	if int64(currentOffset) < totalCount && *pageSize > 0 {
+		endOffset := currentOffset + *pageSize
+		if !hasNextPage {
+			endOffset = count
+		}
+
+		startCursor = codec.Encode(currentOffset + 1)
+		endCursor = codec.Encode(endOffset)
 	}
 
+	estimate := TotalCountEstimate{Value: totalCount, Exact: true}
+
 	return PageInfo{
-		TotalCount:      func() (*int, error) { return &count, nil },
-		StartCursor:     func() (*string, error) { return EncodeOffsetCursor(0), nil },
-		EndCursor:       func() (*string, error) { return EncodeOffsetCursor(endOffset), nil },
-		HasNextPage:     func() (bool, error) { return (currentOffset+*pageSize < count), nil },
-		HasPreviousPage: func() (bool, error) { return (currentOffset-*pageSize > 0), nil },
+		TotalCount:         func() (*int, error) { return &count, nil },
+		StartCursor:        func() (*string, error) { return startCursor, nil },
+		EndCursor:          func() (*string, error) { return endCursor, nil },
+		HasNextPage:        func() (bool, error) { return hasNextPage, nil },
+		HasPreviousPage:    func() (bool, error) { return currentOffset > 0, nil },
+		TotalCountEstimate: func() (*TotalCountEstimate, error) { return &estimate, nil },
+		TotalCountInt64:    func() (*int64, error) { return &totalCount, nil },
 	}
 }
 
 // NewEmptyPageInfo returns a empty instance of PageInfo. Useful for when working on a new page to be able to fullfil PageInfo requirements
 func NewEmptyPageInfo() *PageInfo {
 	return &PageInfo{
-		TotalCount:      func() (*int, error) { return nil, nil },
-		StartCursor:     func() (*string, error) { return nil, nil },
-		EndCursor:       func() (*string, error) { return nil, nil },
-		HasNextPage:     func() (bool, error) { return false, nil },
-		HasPreviousPage: func() (bool, error) { return false, nil },
+		TotalCount:         func() (*int, error) { return nil, nil },
+		StartCursor:        func() (*string, error) { return nil, nil },
+		EndCursor:          func() (*string, error) { return nil, nil },
+		HasNextPage:        func() (bool, error) { return false, nil },
+		HasPreviousPage:    func() (bool, error) { return false, nil },
+		TotalCountEstimate: func() (*TotalCountEstimate, error) { return nil, nil },
+		TotalCountInt64:    func() (*int64, error) { return nil, nil },
+	}
+}
+
+// WithTotalCountEstimate returns a copy of pageInfo reporting an approximate total count.
+// Useful when the exact count is too expensive and an estimate is good enough for "about N results"
+func WithTotalCountEstimate(pageInfo PageInfo, estimate int64) PageInfo {
+	pageInfo.TotalCountEstimate = func() (*TotalCountEstimate, error) {
+		return &TotalCountEstimate{Value: estimate, Exact: false}, nil
+	}
+	return pageInfo
+}
+
+// LastPageCursor returns the cursor that, used as `after`, fetches the last page. It uses the default
+// cursor encoding; with a custom OffsetCursorCodec use OffsetPaginator.LastPageCursor instead
+func LastPageCursor(pageSize int, totalCount int64) *string {
+	return EncodeOffsetCursor(lastPageOffset(pageSize, int(totalCount)))
+}
+
+// lastPageOffset returns the offset of the first item of the last page
+func lastPageOffset(pageSize int, count int) int {
+	if pageSize <= 0 {
+		return 0
+	}
+
+	offset := count - int(math.Mod(float64(count), float64(pageSize)))
+
+	if offset == count {
+		offset = count - pageSize
+	}
+
+	if offset < 0 {
+		return 0
 	}
+	return offset
 }
@@ -11,6 +11,8 @@ type PageInfoResolver interface {
 	TotalCount(ctx context.Context, pageInfo *PageInfo) (*int, error)
 	StartCursor(ctx context.Context, pageInfo *PageInfo) (*string, error)
 	EndCursor(ctx context.Context, pageInfo *PageInfo) (*string, error)
+	TotalCountEstimate(ctx context.Context, pageInfo *PageInfo) (*TotalCountEstimate, error)
+	TotalCountInt64(ctx context.Context, pageInfo *PageInfo) (*int64, error)
 }
 
 type pageInfoResolver struct{}
@@ -39,3 +41,17 @@ func (r *pageInfoResolver) StartCursor(ctx context.Context, pageInfo *PageInfo)
 func (r *pageInfoResolver) EndCursor(ctx context.Context, pageInfo *PageInfo) (*string, error) {
 	return pageInfo.EndCursor()
 }
+
+func (r *pageInfoResolver) TotalCountEstimate(ctx context.Context, pageInfo *PageInfo) (*TotalCountEstimate, error) {
+	if pageInfo.TotalCountEstimate == nil {
+		return nil, nil
+	}
+	return pageInfo.TotalCountEstimate()
+}
+
+func (r *pageInfoResolver) TotalCountInt64(ctx context.Context, pageInfo *PageInfo) (*int64, error) {
+	if pageInfo.TotalCountInt64 == nil {
+		return nil, nil
+	}
+	return pageInfo.TotalCountInt64()
+}
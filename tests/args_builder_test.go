@@ -0,0 +1,53 @@
+package paging_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("ArgsBuilder", func() {
+	It("builds the PageArgs", func() {
+		page, err := paging.NewArgs().
+			First(20).
+			After(paging.EncodeOffsetCursor(40)).
+			Build()
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*page.First).To(Equal(20))
+		Expect(page.After).To(Equal(paging.EncodeOffsetCursor(40)))
+	})
+
+	It("builds backward PageArgs", func() {
+		page, err := paging.NewArgs().Last(20).Before(paging.EncodeOffsetCursor(40)).Build()
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*page.Last).To(Equal(20))
+		Expect(page.Before).To(Equal(paging.EncodeOffsetCursor(40)))
+	})
+
+	It("sets the sorting used by the paginator", func() {
+		page, err := paging.NewArgs().First(20).SortDesc("created_at", "id").Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		expected := paging.WithSortBy(&paging.PageArgs{First: page.First}, true, "created_at", "id")
+		Expect(page).To(Equal(expected))
+	})
+
+	It("returns the validation errors", func() {
+		_, err := paging.NewArgs().First(-1).Build()
+
+		Expect(err).To(BeAssignableToTypeOf(&paging.ArgumentError{}))
+	})
+
+	It("builds independent PageArgs", func() {
+		builder := paging.NewArgs().First(20)
+
+		page1, _ := builder.Build()
+		page2, _ := builder.First(30).Build()
+
+		Expect(*page1.First).To(Equal(20))
+		Expect(*page2.First).To(Equal(30))
+	})
+})
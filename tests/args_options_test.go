@@ -0,0 +1,34 @@
+package paging_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("NewPageArgs", func() {
+	It("returns empty PageArgs without options", func() {
+		Expect(paging.NewPageArgs()).To(Equal(&paging.PageArgs{}))
+	})
+
+	It("applies the options", func() {
+		page := paging.NewPageArgs(paging.First(20), paging.After(paging.EncodeOffsetCursor(40)))
+
+		Expect(*page.First).To(Equal(20))
+		Expect(page.After).To(Equal(paging.EncodeOffsetCursor(40)))
+	})
+
+	It("applies the backward pagination options", func() {
+		page := paging.NewPageArgs(paging.Last(20), paging.Before(paging.EncodeOffsetCursor(40)))
+
+		Expect(*page.Last).To(Equal(20))
+		Expect(page.Before).To(Equal(paging.EncodeOffsetCursor(40)))
+	})
+
+	It("can be passed to the paginator", func() {
+		paginator := paging.NewOffsetPaginator(paging.NewPageArgs(paging.First(20)), 100)
+
+		Expect(paginator.Limit).To(Equal(20))
+	})
+})
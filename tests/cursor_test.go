@@ -1,6 +1,8 @@
 package paging_test
 
 import (
+	"math"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -18,3 +20,104 @@ var _ = Describe("Encode/Decode Offset Cursor", func() {
 		Expect(data).To(Equal(offset))
 	})
 })
+
+var _ = Describe("Relay arrayconnection cursors", func() {
+	It("encodes the cursor the same way graphql-relay-js does", func() {
+		cursor := paging.EncodeArrayConnectionCursor(1)
+		Expect(*cursor).To(Equal("YXJyYXljb25uZWN0aW9uOjA="))
+	})
+
+	It("decodes a Relay cursor to the offset of the next item", func() {
+		cursor := "YXJyYXljb25uZWN0aW9uOjk=" // arrayconnection:9
+
+		Expect(paging.DecodeOffsetCursor(&cursor)).To(Equal(10))
+	})
+
+	It("round trips through the offset cursor decoder", func() {
+		cursor := paging.EncodeArrayConnectionCursor(34)
+
+		Expect(paging.DecodeOffsetCursor(cursor)).To(Equal(34))
+	})
+
+	It("defaults to 0 for invalid cursors", func() {
+		cursor := "not a cursor"
+
+		Expect(paging.DecodeOffsetCursor(&cursor)).To(Equal(0))
+	})
+})
+
+var _ = Describe("Keyed offset cursor codec", func() {
+	var codec paging.OffsetCursorCodec
+
+	BeforeEach(func() {
+		codec = paging.NewKeyedOffsetCursorCodec([]byte("secret"))
+	})
+
+	It("round trips offsets", func() {
+		for _, offset := range []int{0, 1, 34, 65536, 2147483647} {
+			Expect(codec.Decode(codec.Encode(offset))).To(Equal(offset))
+		}
+	})
+
+	It("does not encode offsets out of the 32 bits range", func() {
+		tooLarge := int64(math.MaxInt32) + 1
+
+		Expect(codec.Encode(-1)).To(BeNil())
+		Expect(codec.Encode(int(tooLarge))).To(BeNil())
+	})
+
+	It("panics with an empty key", func() {
+		Expect(func() { paging.NewKeyedOffsetCursorCodec(nil) }).To(Panic())
+		Expect(func() { paging.NewKeyedOffsetCursorCodec([]byte{}) }).To(Panic())
+	})
+
+	It("does not expose the offset", func() {
+		Expect(*codec.Encode(34)).ToNot(Equal(*paging.EncodeOffsetCursor(34)))
+		Expect(*codec.Encode(34)).ToNot(Equal(*codec.Encode(35)))
+	})
+
+	It("depends on the key", func() {
+		other := paging.NewKeyedOffsetCursorCodec([]byte("other"))
+
+		Expect(*other.Encode(34)).ToNot(Equal(*codec.Encode(34)))
+	})
+
+	It("defaults to 0 for cursors it did not produce", func() {
+		forged := "AAAAAAAAAAA"
+		shortForged := "AAAAAA"
+		otherKey := paging.NewKeyedOffsetCursorCodec([]byte("other")).Encode(34)
+
+		Expect(codec.Decode(&forged)).To(Equal(0))
+		Expect(codec.Decode(&shortForged)).To(Equal(0))
+		Expect(codec.Decode(otherKey)).To(Equal(0))
+		Expect(codec.Decode(paging.EncodeOffsetCursor(34))).To(Equal(0))
+		Expect(codec.Decode(nil)).To(Equal(0))
+	})
+
+	It("is used by the offset paginator", func() {
+		first := 10
+		page := paging.WithOffsetCursorCodec(&paging.PageArgs{
+			First: &first,
+			After: codec.Encode(20),
+		}, codec)
+
+		paginator := paging.NewOffsetPaginator(page, 100)
+		Expect(paginator.Offset).To(Equal(20))
+		Expect(paginator.EdgeCursor(0)).To(Equal(codec.Encode(21)))
+
+		endCursor, _ := paginator.PageInfo.EndCursor()
+		Expect(endCursor).To(Equal(codec.Encode(30)))
+	})
+
+	It("encodes the last page cursor of the paginator", func() {
+		first := 10
+		page := paging.WithOffsetCursorCodec(&paging.PageArgs{First: &first}, codec)
+		last := paging.NewOffsetPaginator(page, 95)
+		lastPageCursor := last.LastPageCursor()
+
+		page = paging.WithOffsetCursorCodec(&paging.PageArgs{First: &first, After: lastPageCursor}, codec)
+		paginator := paging.NewOffsetPaginator(page, 95)
+
+		Expect(paginator.Offset).To(Equal(90))
+	})
+})
@@ -0,0 +1,40 @@
+package paging_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("DiffPages", func() {
+	It("reports no changes for the same page", func() {
+		diff := paging.DiffPages([]string{"1", "2", "3"}, []string{"1", "2", "3"})
+
+		Expect(diff).To(Equal(paging.PageDiff{}))
+	})
+
+	It("reports added and removed keys", func() {
+		diff := paging.DiffPages([]string{"1", "2", "3"}, []string{"0", "1", "2"})
+
+		Expect(diff.Added).To(Equal([]string{"0"}))
+		Expect(diff.Removed).To(Equal([]string{"3"}))
+		Expect(diff.Moved).To(BeEmpty())
+	})
+
+	It("reports moved keys", func() {
+		diff := paging.DiffPages([]string{"1", "2", "3", "4"}, []string{"1", "4", "2", "3"})
+
+		Expect(diff.Added).To(BeEmpty())
+		Expect(diff.Removed).To(BeEmpty())
+		Expect(diff.Moved).To(Equal([]string{"4"}))
+	})
+
+	It("handles empty pages", func() {
+		diff := paging.DiffPages(nil, []string{"1", "2"})
+
+		Expect(diff.Added).To(Equal([]string{"1", "2"}))
+		Expect(diff.Removed).To(BeEmpty())
+		Expect(diff.Moved).To(BeEmpty())
+	})
+})
@@ -0,0 +1,54 @@
+package paging_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("PageETag", func() {
+	It("is stable for the same page", func() {
+		etag1 := paging.PageETag([]string{"1", "2", "3"}, paging.EncodeOffsetCursor(10))
+		etag2 := paging.PageETag([]string{"1", "2", "3"}, paging.EncodeOffsetCursor(10))
+
+		Expect(etag1).To(Equal(etag2))
+		Expect(etag1).To(MatchRegexp(`^"[0-9a-f]{32}"$`))
+	})
+
+	It("changes when the records change", func() {
+		etag1 := paging.PageETag([]string{"1", "2", "3"}, nil)
+
+		Expect(paging.PageETag([]string{"1", "3", "2"}, nil)).ToNot(Equal(etag1))
+		Expect(paging.PageETag([]string{"1", "2"}, nil)).ToNot(Equal(etag1))
+		Expect(paging.PageETag([]string{"12", "3"}, nil)).ToNot(Equal(etag1))
+	})
+
+	It("changes when the cursor changes", func() {
+		etag1 := paging.PageETag([]string{"1"}, nil)
+		etag2 := paging.PageETag([]string{"1"}, paging.EncodeOffsetCursor(0))
+
+		Expect(etag1).ToNot(Equal(etag2))
+	})
+})
+
+var _ = Describe("ETagMatches", func() {
+	etag := paging.PageETag([]string{"1", "2"}, nil)
+
+	It("matches the same ETag", func() {
+		Expect(paging.ETagMatches(etag, etag)).To(BeTrue())
+	})
+
+	It("matches any ETag in a list, including weak ones", func() {
+		Expect(paging.ETagMatches(`"other", W/`+etag, etag)).To(BeTrue())
+	})
+
+	It("matches the wildcard", func() {
+		Expect(paging.ETagMatches("*", etag)).To(BeTrue())
+	})
+
+	It("does not match other ETags", func() {
+		Expect(paging.ETagMatches(`"other"`, etag)).To(BeFalse())
+		Expect(paging.ETagMatches("", etag)).To(BeFalse())
+	})
+})
@@ -0,0 +1,85 @@
+package paging_test
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("IDListPaginator", func() {
+	ids := []string{"c", "a", "e", "b", "d"}
+
+	It("returns the IDs of the first page", func() {
+		paginator := paging.NewIDListPaginator(paging.NewPageArgs(paging.First(2)), ids, "id")
+
+		Expect(paginator.IDs).To(Equal([]string{"c", "a"}))
+
+		hasNextPage, _ := paginator.PageInfo.HasNextPage()
+		Expect(hasNextPage).To(Equal(true))
+	})
+
+	It("returns the IDs after the cursor", func() {
+		page := paging.NewPageArgs(paging.First(2), paging.After(paging.EncodeOffsetCursor(4)))
+		paginator := paging.NewIDListPaginator(page, ids, "id")
+
+		Expect(paginator.IDs).To(Equal([]string{"d"}))
+		Expect(paginator.EdgeCursor(0)).To(Equal(paging.EncodeOffsetCursor(5)))
+
+		hasNextPage, _ := paginator.PageInfo.HasNextPage()
+		Expect(hasNextPage).To(Equal(false))
+	})
+
+	It("returns the sqlboiler query mods", func() {
+		paginator := paging.NewIDListPaginator(paging.NewPageArgs(paging.First(2)), ids, "id")
+
+		mods := paginator.QueryMods()
+		Expect(mods).To(HaveLen(1))
+
+		qm1 := reflect.TypeOf(mods[0]).String()
+		Expect(qm1).To(Equal("qm.whereInQueryMod"))
+	})
+
+	It("puts the loaded records back in the order of the list", func() {
+		type record struct{ ID string }
+
+		paginator := paging.NewIDListPaginator(paging.NewPageArgs(paging.First(3)), ids, "id")
+		records := []record{{ID: "a"}, {ID: "e"}, {ID: "c"}}
+
+		keys := make([]string, len(records))
+		for i, r := range records {
+			keys[i] = r.ID
+		}
+
+		var ordered []record
+		for _, index := range paginator.Order(keys) {
+			ordered = append(ordered, records[index])
+		}
+
+		Expect(ordered).To(Equal([]record{{ID: "c"}, {ID: "a"}, {ID: "e"}}))
+	})
+
+	It("marks the records that were not loaded", func() {
+		paginator := paging.NewIDListPaginator(paging.NewPageArgs(paging.First(3)), ids, "id")
+
+		Expect(paginator.Order([]string{"e", "c"})).To(Equal([]int{1, -1, 0}))
+	})
+
+	It("fetches nothing past the end of the list", func() {
+		page := paging.NewPageArgs(paging.After(paging.EncodeOffsetCursor(10)))
+		paginator := paging.NewIDListPaginator(page, ids, "id")
+
+		Expect(paginator.IDs).To(BeEmpty())
+
+		qm1 := reflect.TypeOf(paginator.QueryMods()[0]).String()
+		Expect(qm1).To(Equal("qmhelper.WhereQueryMod"))
+	})
+
+	It("handles a negative first", func() {
+		paginator := paging.NewIDListPaginator(paging.NewPageArgs(paging.First(-1)), ids, "id")
+
+		Expect(paginator.IDs).To(BeEmpty())
+	})
+})
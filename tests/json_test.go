@@ -0,0 +1,97 @@
+package paging_test
+
+import (
+	"encoding/json"
+	"errors"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("PageInfo JSON", func() {
+	It("resolves the page info fields", func() {
+		size := 10
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(100), 20)
+
+		data, err := json.Marshal(pageInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(data).To(MatchJSON(`{
+			"hasPreviousPage": true,
+			"hasNextPage": true,
+			"totalCount": 100,
+			"startCursor": "` + *paging.EncodeOffsetCursor(21) + `",
+			"endCursor": "` + *paging.EncodeOffsetCursor(30) + `",
+			"totalCountEstimate": {"value": 100, "exact": true}
+		}`))
+	})
+
+	It("encodes the total count as int64", func() {
+		size := 10
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(5000000000), 0)
+
+		data, err := json.Marshal(pageInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		var decoded map[string]interface{}
+		Expect(json.Unmarshal(data, &decoded)).To(Succeed())
+		Expect(decoded["totalCount"]).To(Equal(float64(5000000000)))
+	})
+
+	It("resolves the total count once", func() {
+		calls := 0
+		count := int64(100)
+		pageInfo := paging.NewEmptyPageInfo()
+		pageInfo.TotalCount = func() (*int, error) {
+			calls++
+			total := int(count)
+			return &total, nil
+		}
+		pageInfo.TotalCountInt64 = func() (*int64, error) {
+			calls++
+			return &count, nil
+		}
+
+		data, err := json.Marshal(pageInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(calls).To(Equal(1))
+		Expect(data).To(ContainSubstring(`"totalCount":100`))
+	})
+
+	It("falls back to the int total count", func() {
+		pageInfo := paging.NewEmptyPageInfo()
+		pageInfo.TotalCount = func() (*int, error) {
+			total := 100
+			return &total, nil
+		}
+
+		data, err := json.Marshal(pageInfo)
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(data).To(ContainSubstring(`"totalCount":100`))
+	})
+
+	It("encodes an empty page info", func() {
+		data, err := json.Marshal(paging.NewEmptyPageInfo())
+		Expect(err).ToNot(HaveOccurred())
+
+		Expect(data).To(MatchJSON(`{
+			"hasPreviousPage": false,
+			"hasNextPage": false,
+			"totalCount": null,
+			"startCursor": null,
+			"endCursor": null
+		}`))
+	})
+
+	It("returns the errors from the page info fields", func() {
+		pageInfo := paging.NewEmptyPageInfo()
+		pageInfo.TotalCount = func() (*int, error) { return nil, errors.New("count failed") }
+
+		_, err := json.Marshal(pageInfo)
+		Expect(err).To(MatchError(ContainSubstring("count failed")))
+	})
+})
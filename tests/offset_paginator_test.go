@@ -52,10 +52,10 @@ var _ = Describe("OffsetPaginator", func() {
 		Expect(hasPreviousPage).To(Equal(true))
 
 		startCursor, _ := paginator.PageInfo.StartCursor()
-		Expect(startCursor).To(Equal(paging.EncodeOffsetCursor(0)))
+		Expect(startCursor).To(Equal(paging.EncodeOffsetCursor(21)))
 
 		endCursor, _ := paginator.PageInfo.EndCursor()
-		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(90)))
+		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(30)))
 	})
 
 	It("returns the sqlboiler query mods", func() {
@@ -78,4 +78,208 @@ var _ = Describe("OffsetPaginator", func() {
 		qm3 := reflect.TypeOf(mods[2]).String()
 		Expect(qm3).To(Equal("qm.orderByQueryMod"))
 	})
+
+	It("returns the last page cursor", func() {
+		paginator := paging.NewOffsetPaginator(paging.NewPageArgs(paging.First(10)), 95)
+
+		Expect(paginator.LastPageCursor()).To(Equal(paging.EncodeOffsetCursor(90)))
+	})
+
+	It("builds edge cursors for paginators not built with NewOffsetPaginator", func() {
+		paginator := paging.OffsetPaginator{Limit: 10, Offset: 20}
+
+		Expect(paginator.EdgeCursor(0)).To(Equal(paging.EncodeOffsetCursor(21)))
+	})
+
+	Describe("backward pagination", func() {
+		It("returns the last records with last", func() {
+			page := paging.NewPageArgs(paging.Last(10))
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(85))
+			Expect(paginator.Limit).To(Equal(10))
+
+			hasNextPage, _ := paginator.PageInfo.HasNextPage()
+			Expect(hasNextPage).To(Equal(false))
+
+			hasPreviousPage, _ := paginator.PageInfo.HasPreviousPage()
+			Expect(hasPreviousPage).To(Equal(true))
+		})
+
+		It("returns the records before the cursor", func() {
+			// the cursor of the 31st record
+			page := paging.NewPageArgs(paging.Last(10), paging.Before(paging.EncodeOffsetCursor(31)))
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(20))
+			Expect(paginator.Limit).To(Equal(10))
+
+			endCursor, _ := paginator.PageInfo.EndCursor()
+			Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(30)))
+		})
+
+		It("ignores an invalid before cursor", func() {
+			invalid := "invalid"
+			page := paging.NewPageArgs(paging.Last(10), paging.Before(&invalid))
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(85))
+			Expect(paginator.Limit).To(Equal(10))
+		})
+
+		It("walks back from the start cursor of a page", func() {
+			first := paging.NewOffsetPaginator(paging.NewPageArgs(paging.First(10), paging.After(paging.EncodeOffsetCursor(20))), 95)
+			startCursor, _ := first.PageInfo.StartCursor()
+
+			paginator := paging.NewOffsetPaginator(paging.NewPageArgs(paging.Last(10), paging.Before(startCursor)), 95)
+
+			Expect(paginator.Offset).To(Equal(10))
+			Expect(paginator.Limit).To(Equal(10))
+		})
+
+		It("shortens the page at the start of the list", func() {
+			page := paging.NewPageArgs(paging.Last(10), paging.Before(paging.EncodeOffsetCursor(5)))
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(0))
+			Expect(paginator.Limit).To(Equal(4))
+
+			hasPreviousPage, _ := paginator.PageInfo.HasPreviousPage()
+			Expect(hasPreviousPage).To(Equal(false))
+		})
+
+		It("uses the default limit when only before is provided", func() {
+			page := paging.NewPageArgs(paging.Before(paging.EncodeOffsetCursor(81)))
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(30))
+			Expect(paginator.Limit).To(Equal(50))
+		})
+
+		It("stays after the after cursor", func() {
+			page := paging.NewPageArgs(
+				paging.After(paging.EncodeOffsetCursor(25)),
+				paging.Last(10),
+				paging.Before(paging.EncodeOffsetCursor(31)),
+			)
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Offset).To(Equal(25))
+			Expect(paginator.Limit).To(Equal(5))
+		})
+	})
+
+	Describe("metadata-only pages", func() {
+		var page *paging.PageArgs
+
+		BeforeEach(func() {
+			first := 0
+			page = &paging.PageArgs{
+				First: &first,
+				After: paging.EncodeOffsetCursor(20),
+			}
+		})
+
+		It("keeps a limit of 0", func() {
+			paginator := paging.NewOffsetPaginator(page, 100)
+
+			Expect(paginator.Limit).To(Equal(0))
+		})
+
+		It("still computes the page info", func() {
+			paginator := paging.NewOffsetPaginator(page, 100)
+
+			totalCount, _ := paginator.PageInfo.TotalCount()
+			Expect(*totalCount).To(Equal(100))
+
+			hasNextPage, _ := paginator.PageInfo.HasNextPage()
+			Expect(hasNextPage).To(Equal(true))
+
+			startCursor, _ := paginator.PageInfo.StartCursor()
+			Expect(startCursor).To(BeNil())
+
+			endCursor, _ := paginator.PageInfo.EndCursor()
+			Expect(endCursor).To(BeNil())
+		})
+
+		It("adds a false condition so no records are fetched", func() {
+			paginator := paging.NewOffsetPaginator(page, 100)
+
+			mods := paginator.QueryMods()
+			Expect(mods).To(HaveLen(4))
+
+			qm4 := reflect.TypeOf(mods[3]).String()
+			Expect(qm4).To(Equal("qmhelper.WhereQueryMod"))
+		})
+	})
+
+	Describe("out of range cursors", func() {
+		var page *paging.PageArgs
+
+		BeforeEach(func() {
+			first := 10
+			page = &paging.PageArgs{
+				First: &first,
+				After: paging.EncodeOffsetCursor(120),
+			}
+		})
+
+		It("returns an empty page by default", func() {
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Err()).To(BeNil())
+			Expect(paginator.Offset).To(Equal(120))
+		})
+
+		It("reports ErrPageOutOfRange with the error policy", func() {
+			page = paging.WithOutOfRangePolicy(page, paging.OutOfRangeError)
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Err()).To(Equal(paging.ErrPageOutOfRange))
+		})
+
+		It("does not fetch records with the error policy", func() {
+			page = paging.WithOutOfRangePolicy(page, paging.OutOfRangeError)
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			mods := paginator.QueryMods()
+			Expect(mods).To(HaveLen(4))
+
+			qm4 := reflect.TypeOf(mods[3]).String()
+			Expect(qm4).To(Equal("qmhelper.WhereQueryMod"))
+		})
+
+		It("clamps to the last page with the clamp policy", func() {
+			page = paging.WithOutOfRangePolicy(page, paging.OutOfRangeClamp)
+			paginator := paging.NewOffsetPaginator(page, 95)
+
+			Expect(paginator.Err()).To(BeNil())
+			Expect(paginator.Offset).To(Equal(90))
+
+			hasNextPage, _ := paginator.PageInfo.HasNextPage()
+			Expect(hasNextPage).To(Equal(false))
+		})
+
+		It("does not treat the end cursor of the last page as out of range", func() {
+			first := 10
+			last := paging.NewOffsetPaginator(&paging.PageArgs{First: &first, After: paging.EncodeOffsetCursor(90)}, 95)
+			endCursor, _ := last.PageInfo.EndCursor()
+
+			page = paging.WithOutOfRangePolicy(&paging.PageArgs{First: &first, After: endCursor}, paging.OutOfRangeError)
+			paginator := paging.NewOffsetPaginator(page, 95)
+			Expect(paginator.Err()).To(BeNil())
+			Expect(paginator.Offset).To(Equal(95))
+
+			page = paging.WithOutOfRangePolicy(&paging.PageArgs{First: &first, After: endCursor}, paging.OutOfRangeClamp)
+			paginator = paging.NewOffsetPaginator(page, 95)
+			Expect(paginator.Offset).To(Equal(95))
+		})
+
+		It("does not treat the first page of an empty list as out of range", func() {
+			page = paging.WithOutOfRangePolicy(&paging.PageArgs{}, paging.OutOfRangeError)
+			paginator := paging.NewOffsetPaginator(page, 0)
+
+			Expect(paginator.Err()).To(BeNil())
+		})
+	})
 })
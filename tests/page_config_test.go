@@ -0,0 +1,250 @@
+package paging_test
+
+import (
+	"context"
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("Normalize", func() {
+	It("applies the defaults from the config", func() {
+		normalized, _ := paging.Normalize(nil, &paging.PageConfig{DefaultLimit: 20})
+
+		Expect(*normalized.First).To(Equal(20))
+		Expect(normalized.After).To(BeNil())
+	})
+
+	It("falls back to the package default limit", func() {
+		normalized, _ := paging.Normalize(&paging.PageArgs{}, nil)
+
+		Expect(*normalized.First).To(Equal(50))
+	})
+
+	It("trims the cursor", func() {
+		after := "  " + *paging.EncodeOffsetCursor(10) + " "
+		normalized, _ := paging.Normalize(&paging.PageArgs{After: &after}, nil)
+
+		Expect(normalized.After).To(Equal(paging.EncodeOffsetCursor(10)))
+	})
+
+	It("does not modify the given PageArgs", func() {
+		after := " cursor "
+		page := &paging.PageArgs{After: &after}
+
+		paging.Normalize(page, nil)
+
+		Expect(page.First).To(BeNil())
+		Expect(*page.After).To(Equal(" cursor "))
+	})
+
+	It("produces the same hash for equivalent requests", func() {
+		first := 20
+		after := " " + *paging.EncodeOffsetCursor(10)
+
+		_, hash1 := paging.Normalize(
+			paging.WithSortBy(&paging.PageArgs{After: &after}, true, "name", "name", " id"),
+			&paging.PageConfig{DefaultLimit: 20},
+		)
+		_, hash2 := paging.Normalize(
+			paging.WithSortBy(&paging.PageArgs{First: &first, After: paging.EncodeOffsetCursor(10)}, true, "name", "id"),
+			nil,
+		)
+
+		Expect(hash1).To(Equal(hash2))
+	})
+
+	It("does not default first for backward pagination", func() {
+		last := 10
+		normalized, _ := paging.Normalize(&paging.PageArgs{Last: &last}, nil)
+
+		Expect(normalized.First).To(BeNil())
+		Expect(*normalized.Last).To(Equal(10))
+	})
+
+	It("does not mix up cursor contents with other fields", func() {
+		first := 50
+		last := 5
+		after1 := "X;last=5;before=Y"
+		after2 := "X"
+		before2 := "Y;last=;before="
+
+		_, hash1 := paging.Normalize(&paging.PageArgs{After: &after1}, nil)
+		_, hash2 := paging.Normalize(&paging.PageArgs{First: &first, After: &after2, Last: &last, Before: &before2}, nil)
+
+		Expect(hash1).ToNot(Equal(hash2))
+	})
+
+	It("does not mix up sort columns", func() {
+		_, hash1 := paging.Normalize(paging.WithSortBy(nil, false, "a,b"), nil)
+		_, hash2 := paging.Normalize(paging.WithSortBy(nil, false, "a", "b"), nil)
+
+		Expect(hash1).ToNot(Equal(hash2))
+	})
+
+	It("attaches the config it applied", func() {
+		first := 200
+		page := paging.WithPageConfig(&paging.PageArgs{First: &first}, &paging.PageConfig{SoftMaxLimit: 20})
+
+		normalized, _ := paging.Normalize(page, &paging.PageConfig{SoftMaxLimit: 100})
+		Expect(*normalized.First).To(Equal(100))
+
+		paginator := paging.NewOffsetPaginator(normalized, 1000)
+		Expect(paginator.Limit).To(Equal(100))
+	})
+
+	It("uses the attached config when none is given", func() {
+		page := paging.WithPageConfig(&paging.PageArgs{}, &paging.PageConfig{DefaultLimit: 20})
+
+		normalized, _ := paging.Normalize(page, nil)
+		Expect(*normalized.First).To(Equal(20))
+	})
+
+	It("produces different hashes for different requests", func() {
+		_, hash1 := paging.Normalize(paging.WithSortBy(nil, true, "name"), nil)
+		_, hash2 := paging.Normalize(paging.WithSortBy(nil, false, "name"), nil)
+
+		Expect(hash1).ToNot(Equal(hash2))
+	})
+})
+
+var _ = Describe("PageConfig.Validate", func() {
+	It("accepts cursors within the max length", func() {
+		config := &paging.PageConfig{MaxCursorLength: 24}
+
+		Expect(config.Validate(&paging.PageArgs{After: paging.EncodeOffsetCursor(34)})).To(Succeed())
+	})
+
+	It("rejects cursors over the max length", func() {
+		config := &paging.PageConfig{MaxCursorLength: 8}
+
+		err := config.Validate(&paging.PageArgs{After: paging.EncodeOffsetCursor(34)})
+		Expect(err).To(Equal(paging.ErrCursorTooLong))
+	})
+
+	It("does not limit cursors when no max length is set", func() {
+		config := &paging.PageConfig{}
+		after := string(make([]byte, 4096))
+
+		Expect(config.Validate(&paging.PageArgs{After: &after})).To(Succeed())
+	})
+})
+
+var _ = Describe("Page size limits", func() {
+	var config *paging.PageConfig
+
+	BeforeEach(func() {
+		config = &paging.PageConfig{
+			DefaultLimit: 20,
+			SoftMaxLimit: 100,
+			HardMaxLimit: 500,
+		}
+	})
+
+	It("uses the default limit when first is not provided", func() {
+		Expect(config.EffectiveLimit(&paging.PageArgs{})).To(Equal(20))
+	})
+
+	It("clamps first to the soft max", func() {
+		first := 200
+
+		Expect(config.EffectiveLimit(&paging.PageArgs{First: &first})).To(Equal(100))
+		Expect(config.Validate(&paging.PageArgs{First: &first})).To(Succeed())
+	})
+
+	It("rejects first above the hard max", func() {
+		first := 501
+
+		Expect(config.Validate(&paging.PageArgs{First: &first})).To(Equal(paging.ErrPageSizeTooLarge))
+	})
+
+	It("rejects last above the hard max", func() {
+		last := 501
+
+		Expect(config.Validate(&paging.PageArgs{Last: &last})).To(Equal(paging.ErrPageSizeTooLarge))
+	})
+
+	Describe("OffsetPaginator", func() {
+		It("applies the config default limit", func() {
+			paginator := paging.NewOffsetPaginator(paging.WithPageConfig(nil, config), 1000)
+
+			Expect(paginator.Limit).To(Equal(20))
+			Expect(paginator.Err()).To(BeNil())
+		})
+
+		It("clamps to the soft max", func() {
+			first := 200
+			page := paging.WithPageConfig(&paging.PageArgs{First: &first}, config)
+
+			paginator := paging.NewOffsetPaginator(page, 1000)
+
+			Expect(paginator.Limit).To(Equal(100))
+			Expect(paginator.Err()).To(BeNil())
+		})
+
+		It("reports ErrPageSizeTooLarge above the hard max", func() {
+			first := 1000
+			page := paging.WithPageConfig(&paging.PageArgs{First: &first}, config)
+
+			paginator := paging.NewOffsetPaginator(page, 1000)
+
+			Expect(paginator.Err()).To(Equal(paging.ErrPageSizeTooLarge))
+		})
+
+		It("does not fetch records above the hard max", func() {
+			first := 1000
+			page := paging.WithPageConfig(&paging.PageArgs{First: &first}, config)
+
+			paginator := paging.NewOffsetPaginator(page, 1000)
+
+			mods := paginator.QueryMods()
+			Expect(mods).To(HaveLen(4))
+
+			qm4 := reflect.TypeOf(mods[3]).String()
+			Expect(qm4).To(Equal("qmhelper.WhereQueryMod"))
+		})
+	})
+})
+
+var _ = Describe("WithPageConfigFunc", func() {
+	type tierKey struct{}
+
+	var (
+		defaultConfig *paging.PageConfig
+		configFunc    paging.PageConfigFunc
+	)
+
+	BeforeEach(func() {
+		defaultConfig = &paging.PageConfig{SoftMaxLimit: 50}
+		configFunc = func(ctx context.Context) *paging.PageConfig {
+			if ctx.Value(tierKey{}) == "premium" {
+				return &paging.PageConfig{SoftMaxLimit: 500}
+			}
+			return nil
+		}
+	})
+
+	It("uses the config resolved from the context", func() {
+		first := 200
+		ctx := context.WithValue(context.Background(), tierKey{}, "premium")
+
+		page := paging.WithPageConfig(&paging.PageArgs{First: &first}, defaultConfig)
+		page = paging.WithPageConfigFunc(ctx, page, configFunc)
+
+		paginator := paging.NewOffsetPaginator(page, 1000)
+		Expect(paginator.Limit).To(Equal(200))
+	})
+
+	It("keeps the attached config when nothing is resolved", func() {
+		first := 200
+
+		page := paging.WithPageConfig(&paging.PageArgs{First: &first}, defaultConfig)
+		page = paging.WithPageConfigFunc(context.Background(), page, configFunc)
+
+		paginator := paging.NewOffsetPaginator(page, 1000)
+		Expect(paginator.Limit).To(Equal(50))
+	})
+})
@@ -1,6 +1,8 @@
 package paging_test
 
 import (
+	"context"
+
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 
@@ -23,10 +25,10 @@ var _ = Describe("NewOffsetBasedPageInfo", func() {
 		Expect(hasPreviousPage).To(Equal(false))
 
 		startCursor, _ := pageInfo.StartCursor()
-		Expect(startCursor).To(Equal(paging.EncodeOffsetCursor(0)))
+		Expect(startCursor).To(Equal(paging.EncodeOffsetCursor(1)))
 
 		endCursor, _ := pageInfo.EndCursor()
-		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(90)))
+		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(10)))
 	})
 
 	It("hasNextPage works", func() {
@@ -52,8 +54,78 @@ var _ = Describe("NewOffsetBasedPageInfo", func() {
 
 		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(102), 100)
 
+		startCursor, _ := pageInfo.StartCursor()
+		Expect(startCursor).To(Equal(paging.EncodeOffsetCursor(101)))
+
 		endCursor, _ := pageInfo.EndCursor()
-		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(100)))
+		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(102)))
+	})
+
+	It("has no cursors for an empty page", func() {
+		size := 10
+
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(100), 100)
+
+		startCursor, _ := pageInfo.StartCursor()
+		Expect(startCursor).To(BeNil())
+
+		endCursor, _ := pageInfo.EndCursor()
+		Expect(endCursor).To(BeNil())
+	})
+
+	It("reports the total count as int64", func() {
+		size := 10
+
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(5000000000), 0)
+
+		totalCount, _ := pageInfo.TotalCountInt64()
+		Expect(*totalCount).To(Equal(int64(5000000000)))
+	})
+
+	It("compares offsets with an int64 total count", func() {
+		size := 10
+
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(5000000000), 100)
+
+		hasNextPage, _ := pageInfo.HasNextPage()
+		Expect(hasNextPage).To(Equal(true))
+
+		endCursor, _ := pageInfo.EndCursor()
+		Expect(endCursor).To(Equal(paging.EncodeOffsetCursor(110)))
+	})
+
+	It("reports the total count as an exact estimate", func() {
+		size := 10
+
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(100), 0)
+
+		estimate, _ := pageInfo.TotalCountEstimate()
+		Expect(*estimate).To(Equal(paging.TotalCountEstimate{Value: 100, Exact: true}))
+	})
+})
+
+var _ = Describe("LastPageCursor", func() {
+	It("points at the start of the last page", func() {
+		Expect(paging.LastPageCursor(10, 102)).To(Equal(paging.EncodeOffsetCursor(100)))
+		Expect(paging.LastPageCursor(10, 100)).To(Equal(paging.EncodeOffsetCursor(90)))
+	})
+
+	It("points at the first page when there are no records", func() {
+		Expect(paging.LastPageCursor(10, 0)).To(Equal(paging.EncodeOffsetCursor(0)))
+	})
+})
+
+var _ = Describe("WithTotalCountEstimate", func() {
+	It("replaces the estimate with an approximate value", func() {
+		size := 10
+
+		pageInfo := paging.WithTotalCountEstimate(paging.NewOffsetBasedPageInfo(&size, int64(100), 0), 12000)
+
+		estimate, _ := pageInfo.TotalCountEstimate()
+		Expect(*estimate).To(Equal(paging.TotalCountEstimate{Value: 12000, Exact: false}))
+
+		totalCount, _ := pageInfo.TotalCount()
+		Expect(*totalCount).To(Equal(100))
 	})
 })
 
@@ -75,5 +147,28 @@ var _ = Describe("NewEmptyPageInfo", func() {
 
 		endCursor, _ := pageInfo.EndCursor()
 		Expect(endCursor).To(BeNil())
+
+		estimate, _ := pageInfo.TotalCountEstimate()
+		Expect(estimate).To(BeNil())
+
+		totalCountInt64, _ := pageInfo.TotalCountInt64()
+		Expect(totalCountInt64).To(BeNil())
+	})
+})
+
+var _ = Describe("PageInfoResolver", func() {
+	It("resolves the total count as int64", func() {
+		size := 10
+		pageInfo := paging.NewOffsetBasedPageInfo(&size, int64(5000000000), 0)
+
+		totalCount, err := paging.NewPageInfoResolver().TotalCountInt64(context.Background(), &pageInfo)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(*totalCount).To(Equal(int64(5000000000)))
+	})
+
+	It("resolves a missing int64 total count to nil", func() {
+		totalCount, err := paging.NewPageInfoResolver().TotalCountInt64(context.Background(), &paging.PageInfo{})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(totalCount).To(BeNil())
 	})
 })
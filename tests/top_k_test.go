@@ -0,0 +1,94 @@
+package paging_test
+
+import (
+	"reflect"
+
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/volatiletech/sqlboiler/v4/drivers"
+	"github.com/volatiletech/sqlboiler/v4/queries"
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("TopKQueryMods", func() {
+	It("returns the limit and order by query mods", func() {
+		mods, err := paging.TopKQueryMods(paging.WithSortBy(nil, true, "score"), 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mods).To(HaveLen(2))
+
+		qm1 := reflect.TypeOf(mods[0]).String()
+		Expect(qm1).To(Equal("qm.limitQueryMod"))
+
+		qm2 := reflect.TypeOf(mods[1]).String()
+		Expect(qm2).To(Equal("qm.orderByQueryMod"))
+	})
+
+	It("handles a nil PageArgs", func() {
+		mods, err := paging.TopKQueryMods(nil, 10)
+
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mods).To(HaveLen(2))
+	})
+
+	It("rejects an after cursor", func() {
+		page := &paging.PageArgs{After: paging.EncodeOffsetCursor(10)}
+
+		_, err := paging.TopKQueryMods(page, 10)
+		Expect(err).To(Equal(paging.ErrCursorNotAllowed))
+	})
+
+	It("rejects a before cursor", func() {
+		page := &paging.PageArgs{Before: paging.EncodeOffsetCursor(10)}
+
+		_, err := paging.TopKQueryMods(page, 10)
+		Expect(err).To(Equal(paging.ErrCursorNotAllowed))
+	})
+
+	It("rejects last", func() {
+		last := 3
+		page := &paging.PageArgs{Last: &last}
+
+		mods, err := paging.TopKQueryMods(page, 10)
+		Expect(mods).To(BeNil())
+		Expect(err).To(Equal(&paging.ArgumentError{
+			Argument: "last",
+			Message:  "`last` can't be used for the first records",
+		}))
+	})
+
+	It("rejects first above the hard max of the config", func() {
+		page := paging.WithPageConfig(paging.NewPageArgs(paging.First(200)), &paging.PageConfig{HardMaxLimit: 100})
+
+		_, err := paging.TopKQueryMods(page, 500)
+		Expect(err).To(Equal(paging.ErrPageSizeTooLarge))
+	})
+
+	It("clamps the limit to the soft max of the config", func() {
+		page := paging.WithPageConfig(nil, &paging.PageConfig{SoftMaxLimit: 20})
+
+		mods, err := paging.TopKQueryMods(page, 50)
+		Expect(err).ToNot(HaveOccurred())
+
+		query := &queries.Query{}
+		queries.SetDialect(query, &drivers.Dialect{LQ: '"', RQ: '"'})
+		queries.SetFrom(query, "posts")
+		qm.Apply(query, mods...)
+
+		sql, _ := queries.BuildQuery(query)
+		Expect(sql).To(ContainSubstring("LIMIT 20"))
+	})
+
+	It("fetches nothing when first is 0", func() {
+		first := 0
+
+		mods, err := paging.TopKQueryMods(&paging.PageArgs{First: &first}, 10)
+		Expect(err).ToNot(HaveOccurred())
+		Expect(mods).To(HaveLen(1))
+
+		qm1 := reflect.TypeOf(mods[0]).String()
+		Expect(qm1).To(Equal("qmhelper.WhereQueryMod"))
+	})
+})
@@ -0,0 +1,67 @@
+package paging_test
+
+import (
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	"github.com/nrfta/go-paging"
+)
+
+var _ = Describe("ValidateRelayArgs", func() {
+	It("accepts empty args", func() {
+		Expect(paging.ValidateRelayArgs(nil)).To(Succeed())
+		Expect(paging.ValidateRelayArgs(&paging.PageArgs{})).To(Succeed())
+	})
+
+	It("accepts first with after", func() {
+		first := 10
+		page := &paging.PageArgs{First: &first, After: paging.EncodeOffsetCursor(10)}
+
+		Expect(paging.ValidateRelayArgs(page)).To(Succeed())
+	})
+
+	It("rejects a negative first", func() {
+		first := -1
+
+		err := paging.ValidateRelayArgs(&paging.PageArgs{First: &first})
+		Expect(err).To(Equal(&paging.ArgumentError{
+			Argument: "first",
+			Message:  "`first` must be a non-negative integer",
+		}))
+	})
+
+	It("accepts last with before", func() {
+		last := 10
+		page := &paging.PageArgs{Last: &last, Before: paging.EncodeOffsetCursor(10)}
+
+		Expect(paging.ValidateRelayArgs(page)).To(Succeed())
+	})
+
+	It("rejects a negative last", func() {
+		last := -1
+
+		err := paging.ValidateRelayArgs(&paging.PageArgs{Last: &last})
+		Expect(err.(*paging.ArgumentError).Argument).To(Equal("last"))
+	})
+
+	It("rejects first with last", func() {
+		first := 10
+		last := 10
+
+		err := paging.ValidateRelayArgs(&paging.PageArgs{First: &first, Last: &last})
+		Expect(err).To(MatchError("`first` and `last` can't be used together"))
+	})
+
+	It("rejects before without last", func() {
+		err := paging.ValidateRelayArgs(&paging.PageArgs{Before: paging.EncodeOffsetCursor(10)})
+
+		Expect(err.(*paging.ArgumentError).Argument).To(Equal("before"))
+	})
+
+	It("rejects after without first", func() {
+		err := paging.ValidateRelayArgs(&paging.PageArgs{After: paging.EncodeOffsetCursor(10)})
+
+		Expect(err).To(HaveOccurred())
+		Expect(err.(*paging.ArgumentError).Argument).To(Equal("after"))
+	})
+})
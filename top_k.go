@@ -0,0 +1,49 @@
+package paging
+
+import (
+	"github.com/volatiletech/sqlboiler/v4/queries/qm"
+)
+
+// TopKQueryMods returns the sqlboiler query mods to fetch the first k records (or `first`, if smaller),
+// for lists that only ever show the first page, like leaderboards or "recent 10" widgets.
+// It needs no total count and builds no cursors. The PageConfig attached with WithPageConfig is applied
+// like in NewOffsetPaginator. It returns ErrCursorNotAllowed when a cursor is set, an *ArgumentError when
+// `last` is set, ErrSortConflict for conflicting sort columns and the PageConfig validation errors
+func TopKQueryMods(page *PageArgs, k int) ([]qm.QueryMod, error) {
+	if page == nil {
+		page = &PageArgs{}
+	}
+
+	if page.After != nil || page.Before != nil {
+		return nil, ErrCursorNotAllowed
+	}
+
+	if page.Last != nil {
+		return nil, &ArgumentError{Argument: "last", Message: "`last` can't be used for the first records"}
+	}
+
+	if err := page.config.Validate(page); err != nil {
+		return nil, err
+	}
+
+	limit := k
+	if page.First != nil && *page.First < k {
+		limit = *page.First
+	}
+
+	limit = page.config.clamp(limit)
+
+	orderBy, err := orderByClause(page)
+	if err != nil {
+		return nil, err
+	}
+
+	if limit <= 0 {
+		return []qm.QueryMod{qm.Where("1 = 0")}, nil
+	}
+
+	return []qm.QueryMod{
+		qm.Limit(limit),
+		qm.OrderBy(orderBy),
+	}, nil
+}
@@ -0,0 +1,32 @@
+package paging
+
+// ValidateRelayArgs checks the PageArgs against the Relay connection rules: `first` and `last` must not be
+// negative nor used together, `after` can only be used along with `first` and `before` along with `last`.
+// It returns an *ArgumentError for the first rule broken, so it can be called at the top of a resolver
+func ValidateRelayArgs(pa *PageArgs) error {
+	if pa == nil {
+		return nil
+	}
+
+	if pa.First != nil && *pa.First < 0 {
+		return &ArgumentError{Argument: "first", Message: "`first` must be a non-negative integer"}
+	}
+
+	if pa.Last != nil && *pa.Last < 0 {
+		return &ArgumentError{Argument: "last", Message: "`last` must be a non-negative integer"}
+	}
+
+	if pa.First != nil && pa.Last != nil {
+		return &ArgumentError{Argument: "last", Message: "`first` and `last` can't be used together"}
+	}
+
+	if pa.After != nil && pa.First == nil {
+		return &ArgumentError{Argument: "after", Message: "`after` can only be used along with `first`"}
+	}
+
+	if pa.Before != nil && pa.Last == nil {
+		return &ArgumentError{Argument: "before", Message: "`before` can only be used along with `last`"}
+	}
+
+	return nil
+}